@@ -7,8 +7,39 @@ import (
 
 type Config struct {
 	RPCURL          string `yaml:"rpc_url"`
-	PrivateKey      string `yaml:"private_key"`
+	WSURL           string `yaml:"ws_url,omitempty"`
 	ContractAddress string `yaml:"contract_address"`
+
+	// PrivateKey is the legacy plaintext hex private key. New configs
+	// should use Signer instead; this is kept only so older config
+	// files keep loading.
+	PrivateKey string `yaml:"private_key,omitempty"`
+
+	// Signer selects and configures the transaction signing backend.
+	// If unset and PrivateKey is present, the "privatekey" backend is
+	// used for backwards compatibility.
+	Signer SignerConfig `yaml:"signer,omitempty"`
+}
+
+// SignerConfig configures how transactions are signed. Type selects the
+// backend: "privatekey", "keystore", "mnemonic" or "clef".
+type SignerConfig struct {
+	Type string `yaml:"type,omitempty"`
+
+	// PrivateKey backend.
+	PrivateKey string `yaml:"private_key,omitempty"`
+
+	// Keystore backend: a go-ethereum V3 keystore JSON file.
+	KeystorePath       string `yaml:"keystore_path,omitempty"`
+	KeystorePassphrase string `yaml:"keystore_passphrase,omitempty"`
+
+	// Mnemonic backend: BIP39 mnemonic with BIP44 derivation path.
+	Mnemonic       string `yaml:"mnemonic,omitempty"`
+	DerivationPath string `yaml:"derivation_path,omitempty"`
+
+	// Clef backend: external signer over RPC.
+	ClefEndpoint string `yaml:"clef_endpoint,omitempty"`
+	ClefAccount  string `yaml:"clef_account,omitempty"`
 }
 
 func LoadConfig(configPath string) (*Config, error) {
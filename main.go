@@ -4,54 +4,50 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"fmt"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/pantheons-ai/sdk-go/config"
 	"github.com/pantheons-ai/sdk-go/pkg/pantheon"
+	"github.com/pantheons-ai/sdk-go/pkg/wallet"
 	"log"
 	"math/big"
 	"strings"
 )
 
 func main() {
+	ctx := context.Background()
+
 	// 加载配置文件
 	cfg, err := config.LoadConfig("config/config.yml")
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
-	// 初始化区块链客户端
-	client, err := ethclient.Dial(cfg.RPCURL)
+	// 获取链ID（用于签名者，单独拨号一次即可）
+	dialClient, err := ethclient.Dial(cfg.RPCURL)
 	if err != nil {
 		log.Fatalf("Failed to connect to the Ethereum client: %v", err)
 	}
-
-	// 加载私钥
-	privateKey, err := crypto.HexToECDSA(cfg.PrivateKey)
-	if err != nil {
-		log.Fatalf("Failed to load private key: %v", err)
-	}
-
-	// 获取链ID
-	chainID, err := client.NetworkID(context.Background())
+	chainID, err := dialClient.NetworkID(ctx)
 	if err != nil {
 		log.Fatalf("Failed to get network ID: %v", err)
 	}
+	dialClient.Close()
 
-	// 创建交易签名者
-	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	// 创建交易签名者（keystore / 助记词 / clef，取决于 config 中的 signer 配置）
+	auth, _, err := wallet.NewTransactor(cfg, chainID)
 	if err != nil {
 		log.Fatalf("Failed to create transaction signer: %v", err)
 	}
 
-	// 设置合约地址并创建合约实例
+	// 创建高层 Pantheon 客户端
 	contractAddress := common.HexToAddress(cfg.ContractAddress)
-	instance, err := pantheon.NewPantheon(contractAddress, client)
+	client, err := pantheon.NewClient(cfg.RPCURL, contractAddress, auth)
 	if err != nil {
-		log.Fatalf("Failed to instantiate a Pantheon contract: %v", err)
+		log.Fatalf("Failed to create Pantheon client: %v", err)
 	}
+	defer client.Close()
 
 	// 生成一个新的随机私钥（新用户）
 	newPrivateKey, err := crypto.GenerateKey()
@@ -70,84 +66,68 @@ func main() {
 	newAddress := crypto.PubkeyToAddress(*newPublicKeyECDSA)
 	fmt.Printf("New random address generated: %s\n", newAddress.Hex())
 
-	// 调用addToWhitelist方法
-	tx, err := instance.AddToWhitelist(auth, newAddress)
-	if err != nil {
-		log.Fatalf("Failed to invoke addToWhitelist: %v", err)
+	// 将新地址加入白名单
+	if _, err := client.WhitelistAdd(ctx, newAddress); err != nil {
+		log.Fatalf("Failed to add address to whitelist: %v", err)
 	}
 
-	// 输出交易哈希
-	fmt.Printf("Whitelist add transaction sent: %s\n", tx.Hash().Hex())
-
-	// 等待交易被挖掘
-	fmt.Println("Waiting for transaction to be mined...")
-	bind.WaitMined(context.Background(), client, tx)
-
 	// 验证地址是否已经加入白名单
-	isWhitelisted, err := instance.IsWhitelisted(&bind.CallOpts{}, newAddress)
+	isWhitelisted, err := client.IsWhitelisted(newAddress)
 	if err != nil {
 		log.Fatalf("Failed to invoke isWhitelisted: %v", err)
 	}
-
-	// 输出验证结果
 	fmt.Printf("Address %s whitelisted status: %v\n", newAddress.Hex(), isWhitelisted)
 
 	// 创建ERC404实例
-	tx, err = instance.CreateERC404(auth, "TestToken", "TTK", 18, big.NewInt(0), auth.From)
+	event, _, err := client.CreateERC404(ctx, pantheon.CreateERC404Params{
+		Name:     "TestToken",
+		Symbol:   "TTK",
+		Decimals: 18,
+		Supply:   big.NewInt(0),
+		Owner:    newAddress,
+	})
 	if err != nil {
 		log.Fatalf("Failed to create ERC404: %v", err)
 	}
-	fmt.Printf("Create ERC404 transaction sent: %s\n", tx.Hash().Hex())
+	fmt.Printf("ERC404Created event received: id=%s, contractAddress=%s\n", event.Id.String(), event.ContractAddress.Hex())
 
-	// 等待交易被挖掘
-	fmt.Println("Waiting for transaction to be mined...")
-	receipt, err := bind.WaitMined(context.Background(), client, tx)
-	if err != nil {
-		log.Fatalf("Failed to mine CreateERC404 transaction: %v", err)
-	}
+	// 如果配置了 WebSocket 地址，订阅 CIDsAdded 事件，实时打印链上推送
+	if cfg.WSURL != "" {
+		subClient := pantheon.NewSubscriptionClient(cfg.WSURL, contractAddress)
+		cidsAdded := make(chan *pantheon.PantheonCIDsAdded)
+		sub, err := subClient.SubscribeCIDsAdded(ctx, cidsAdded)
+		if err != nil {
+			log.Fatalf("Failed to subscribe to CIDsAdded: %v", err)
+		}
+		defer sub.Unsubscribe()
 
-	// 过滤ERC404Created事件
-	blockNumber := receipt.BlockNumber.Uint64()
-	eventIterator, err := instance.FilterERC404Created(&bind.FilterOpts{Start: blockNumber, End: &blockNumber}, nil, nil)
-	if err != nil {
-		log.Fatalf("Failed to filter ERC404Created events: %v", err)
-	}
-	defer eventIterator.Close()
-
-	// 获取事件
-	if eventIterator.Next() {
-		event := eventIterator.Event
-		fmt.Printf("ERC404Created event received: id=%s, contractAddress=%s\n", event.Id.String(), event.ContractAddress.Hex())
-	} else if eventIterator.Error() != nil {
-		log.Fatalf("Error during iteration: %v", err)
-	} else {
-		log.Fatal("ERC404Created event not found in the transaction receipt")
+		go func() {
+			for evt := range cidsAdded {
+				fmt.Printf("CIDsAdded event received: tokenId=%s owner=%s cids=%s\n", evt.TokenId.String(), evt.Owner.Hex(), strings.Join(evt.Cids, ", "))
+			}
+		}()
 	}
 
-	// 添加CIDs
-	cids := []string{"cid1", "cid2", "cid3"}
-	tx, err = instance.AddCIDs(auth, big.NewInt(0), auth.From, cids)
-	if err != nil {
+	// 添加CIDs（启用校验，确保上链前每个 CID 都是格式正确的 CIDv0/CIDv1）
+	cids := []string{
+		"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		"bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+	}
+	if _, err := client.AddCIDs(ctx, big.NewInt(0), newAddress, cids, pantheon.WithValidation(true)); err != nil {
 		log.Fatalf("Failed to add CIDs: %v", err)
 	}
-	fmt.Printf("Add CIDs transaction sent: %s\n", tx.Hash().Hex())
-
-	// 等待交易被挖掘
-	fmt.Println("Waiting for transaction to be mined...")
-	bind.WaitMined(context.Background(), client, tx)
 
 	// 查询贡献
-	contribution, err := instance.GetContribution(&bind.CallOpts{}, big.NewInt(0), auth.From)
+	contribution, err := client.GetContribution(big.NewInt(0), newAddress)
 	if err != nil {
 		log.Fatalf("Failed to get contribution: %v", err)
 	}
 	fmt.Printf("Contribution: %s\n", contribution.String())
 
 	// 查询CID列表
-	storedCIDs, err := instance.GetCIDs(&bind.CallOpts{}, big.NewInt(0), auth.From)
+	storedCIDs, err := client.GetCIDs(big.NewInt(0), newAddress)
 	if err != nil {
 		log.Fatalf("Failed to get CIDs: %v", err)
 	}
 	fmt.Printf("CIDs: %s\n", strings.Join(storedCIDs, ", "))
-
 }
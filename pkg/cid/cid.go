@@ -0,0 +1,82 @@
+// Package cid validates IPFS CIDs and checks their availability before
+// they're recorded on-chain, so a contribution can't be backed by a
+// string that was never a real CID or never actually pinned.
+package cid
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gocid "github.com/ipfs/go-cid"
+)
+
+// Validate parses s as a CIDv0 or CIDv1 string and returns an error if it
+// isn't well-formed.
+func Validate(s string) error {
+	if _, err := gocid.Decode(s); err != nil {
+		return fmt.Errorf("cid: invalid CID %q: %w", s, err)
+	}
+	return nil
+}
+
+// ValidateAll validates every entry in cids, returning the first error
+// encountered.
+func ValidateAll(cids []string) error {
+	for _, c := range cids {
+		if err := Validate(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pin asks the IPFS node's HTTP API at apiURL (e.g. "http://localhost:5001")
+// to pin cidStr via POST /api/v0/pin/add.
+func Pin(ctx context.Context, apiURL, cidStr string) error {
+	if err := Validate(cidStr); err != nil {
+		return err
+	}
+
+	url := strings.TrimRight(apiURL, "/") + "/api/v0/pin/add?arg=" + cidStr
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return fmt.Errorf("cid: build pin request for %s: %w", cidStr, err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("cid: pin %s: %w", cidStr, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("cid: pin %s: ipfs api returned %s", cidStr, resp.Status)
+	}
+
+	return nil
+}
+
+// Available reports whether cidStr resolves on the IPFS gateway at
+// gatewayURL (e.g. "https://ipfs.io") via a HEAD request to
+// gatewayURL/ipfs/<cid>.
+func Available(ctx context.Context, gatewayURL, cidStr string) (bool, error) {
+	if err := Validate(cidStr); err != nil {
+		return false, err
+	}
+
+	url := strings.TrimRight(gatewayURL, "/") + "/ipfs/" + cidStr
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false, fmt.Errorf("cid: build availability request for %s: %w", cidStr, err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("cid: check availability of %s: %w", cidStr, err)
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK, nil
+}
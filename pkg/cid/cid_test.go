@@ -0,0 +1,51 @@
+package cid
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		cid     string
+		wantErr bool
+	}{
+		{name: "valid CIDv0", cid: "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", wantErr: false},
+		{name: "valid CIDv1", cid: "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", wantErr: false},
+		{name: "not a CID", cid: "cid1", wantErr: true},
+		{name: "empty string", cid: "", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.cid)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Validate(%q) error = %v, wantErr %v", tc.cid, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	good := []string{"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"}
+	if err := ValidateAll(good); err != nil {
+		t.Fatalf("ValidateAll(%v) = %v, want nil", good, err)
+	}
+
+	bad := []string{"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG", "cid1"}
+	if err := ValidateAll(bad); err == nil {
+		t.Fatalf("ValidateAll(%v) = nil, want an error for the invalid entry", bad)
+	}
+}
+
+func TestAvailableRejectsInvalidCIDBeforeAnyRequest(t *testing.T) {
+	// "https://" has no host, so a request to it would fail too; only
+	// Validate's "invalid CID" error shows that Available rejected the
+	// CID before building or sending any request.
+	_, err := Available(context.Background(), "https://", "not-a-cid")
+	if err == nil || !strings.Contains(err.Error(), "invalid CID") {
+		t.Fatalf("Available(%q) error = %v, want a validation error", "not-a-cid", err)
+	}
+}
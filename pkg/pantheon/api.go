@@ -0,0 +1,27 @@
+package pantheon
+
+//go:generate mockgen -source=api.go -destination=pantheonmock/mock_client.go -package=pantheonmock
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// API is the interface Client implements. It's extracted so downstream
+// projects can depend on it instead of the concrete type, and inject a
+// pantheontest client or a generated mock in their own tests.
+type API interface {
+	CreateERC404(ctx context.Context, params CreateERC404Params) (*PantheonERC404Created, *types.Receipt, error)
+	AddCIDs(ctx context.Context, tokenID *big.Int, owner common.Address, cids []string, opts ...AddCIDsOption) (*types.Receipt, error)
+	WhitelistAdd(ctx context.Context, addr common.Address) (*types.Receipt, error)
+	IsWhitelisted(addr common.Address) (bool, error)
+	GetContribution(tokenID *big.Int, owner common.Address) (*big.Int, error)
+	GetCIDs(tokenID *big.Int, owner common.Address) ([]string, error)
+	Address() common.Address
+	Close()
+}
+
+var _ API = (*Client)(nil)
@@ -0,0 +1,327 @@
+package pantheon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/pantheons-ai/sdk-go/pkg/cid"
+	"github.com/pantheons-ai/sdk-go/pkg/txmgr"
+)
+
+// Backend is the subset of *ethclient.Client that Client needs to submit
+// transactions, wait for them to be mined, and make contract calls. A
+// *backends.SimulatedBackend satisfies it too, which is what lets
+// pantheontest build a Client without a live RPC node. It is a superset
+// of txmgr.Backend (it adds the contract-call and log-filtering methods
+// of bind.ContractBackend), so NewClientWithBackend can hand eth straight
+// to the txmgr.Manager it wires up by default.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Client is a high-level wrapper around the generated Pantheon contract
+// binding. It owns the RPC connection and a signer, and collapses the
+// usual submit/wait/decode sequence into single intent-level calls.
+type Client struct {
+	eth      Backend
+	auth     *bind.TransactOpts
+	contract *Pantheon
+	address  common.Address
+	txmgr    *txmgr.Manager
+}
+
+// ClientOption configures optional Client behaviour.
+type ClientOption func(*Client)
+
+// WithTxManager routes every state-changing call through mgr instead of
+// the default one NewClient/NewClientWithBackend build from
+// txmgr.LegacyGasPolicy, so callers that need EIP-1559 pricing, an
+// OracleGasPolicy, or non-default timeouts can supply their own
+// pre-configured Manager.
+func WithTxManager(mgr *txmgr.Manager) ClientOption {
+	return func(c *Client) {
+		c.txmgr = mgr
+	}
+}
+
+// NewClient dials rpcURL, binds to the Pantheon contract at
+// contractAddress, and returns a Client that signs transactions with auth.
+func NewClient(rpcURL string, contractAddress common.Address, auth *bind.TransactOpts, opts ...ClientOption) (*Client, error) {
+	eth, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("pantheon: dial %s: %w", rpcURL, err)
+	}
+
+	return NewClientWithBackend(eth, contractAddress, auth, opts...)
+}
+
+// NewClientWithBackend builds a Client around an already-dialed
+// ethclient.Client, or any other Backend such as a
+// *backends.SimulatedBackend, useful for callers that want to reuse a
+// connection or inject a test backend. Every state-changing call is
+// routed through a txmgr.Manager so nonces and gas prices are managed
+// safely even under concurrent submission; pass WithTxManager to use a
+// differently configured one.
+func NewClientWithBackend(eth Backend, contractAddress common.Address, auth *bind.TransactOpts, opts ...ClientOption) (*Client, error) {
+	contract, err := NewPantheon(contractAddress, eth)
+	if err != nil {
+		return nil, fmt.Errorf("pantheon: bind contract: %w", err)
+	}
+
+	c := &Client{eth: eth, auth: auth, contract: contract, address: contractAddress}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.txmgr == nil {
+		c.txmgr = txmgr.NewManager(eth, txmgr.LegacyGasPolicy{})
+	}
+
+	return c, nil
+}
+
+// transactOpts returns the TransactOpts to use for one state-changing call:
+// the Client's own auth, or a nonce-and-gas-managed copy of it when a
+// txmgr.Manager is configured.
+func (c *Client) transactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	if c.txmgr == nil {
+		return c.auth, nil
+	}
+	return c.txmgr.PrepareOpts(ctx, c.auth)
+}
+
+// releaseOpts returns opts's reserved nonce to the manager when a
+// transaction built from it was never successfully broadcast.
+func (c *Client) releaseOpts(opts *bind.TransactOpts) {
+	if c.txmgr != nil && opts.Nonce != nil {
+		c.txmgr.Release(opts.From, opts.Nonce.Uint64())
+	}
+}
+
+// waitMined waits for tx to be mined, handing off to the configured
+// txmgr.Manager so stuck transactions are bumped and rebroadcast. It
+// returns an error if the transaction was mined but reverted, so callers
+// never mistake a failed on-chain call for success.
+func (c *Client) waitMined(ctx context.Context, tx *types.Transaction, opts *bind.TransactOpts) (*types.Receipt, error) {
+	var (
+		receipt *types.Receipt
+		err     error
+	)
+	if c.txmgr == nil {
+		receipt, err = bind.WaitMined(ctx, c.eth, tx)
+	} else {
+		receipt, err = c.txmgr.Wait(ctx, tx, opts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("pantheon: tx %s reverted", tx.Hash())
+	}
+	return receipt, nil
+}
+
+// Close releases the underlying RPC connection, if the Backend has one to
+// release. Backend doesn't require a Close method since not every
+// implementation (e.g. *backends.SimulatedBackend) needs one, so this
+// checks for either of the two signatures in common use.
+func (c *Client) Close() {
+	switch closer := c.eth.(type) {
+	case interface{ Close() error }:
+		closer.Close()
+	case interface{ Close() }:
+		closer.Close()
+	}
+}
+
+// Address returns the bound Pantheon contract address.
+func (c *Client) Address() common.Address {
+	return c.address
+}
+
+// CreateERC404Params collects the arguments for CreateERC404.
+type CreateERC404Params struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+	Supply   *big.Int
+	Owner    common.Address
+}
+
+// CreateERC404 submits a createERC404 transaction, waits for it to be
+// mined, and decodes the resulting ERC404Created event out of the receipt
+// logs.
+func (c *Client) CreateERC404(ctx context.Context, params CreateERC404Params) (*PantheonERC404Created, *types.Receipt, error) {
+	opts, err := c.transactOpts(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pantheon: createERC404: %w", err)
+	}
+
+	tx, err := c.contract.CreateERC404(opts, params.Name, params.Symbol, params.Decimals, params.Supply, params.Owner)
+	if err != nil {
+		c.releaseOpts(opts)
+		return nil, nil, fmt.Errorf("pantheon: createERC404: %w", err)
+	}
+
+	receipt, err := c.waitMined(ctx, tx, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("pantheon: wait for createERC404: %w", err)
+	}
+
+	event, err := findERC404Created(c.contract, receipt)
+	if err != nil {
+		return nil, receipt, err
+	}
+
+	return event, receipt, nil
+}
+
+// AddCIDsOption configures optional validation/pinning behaviour for
+// Client.AddCIDs.
+type AddCIDsOption func(*addCIDsConfig)
+
+type addCIDsConfig struct {
+	strict     bool
+	pinAPI     string
+	gatewayURL string
+}
+
+// WithValidation rejects any CID that doesn't parse as CIDv0/CIDv1 before
+// submitting the transaction, instead of letting the chain record garbage.
+func WithValidation(strict bool) AddCIDsOption {
+	return func(cfg *addCIDsConfig) {
+		cfg.strict = strict
+	}
+}
+
+// WithPinning pins every CID to the IPFS node's HTTP API at ipfsAPI (e.g.
+// "http://localhost:5001") before submitting the transaction.
+func WithPinning(ipfsAPI string) AddCIDsOption {
+	return func(cfg *addCIDsConfig) {
+		cfg.pinAPI = ipfsAPI
+	}
+}
+
+// WithGatewayCheck verifies every CID resolves on gatewayURL (e.g.
+// "https://ipfs.io") before submitting the transaction.
+func WithGatewayCheck(gatewayURL string) AddCIDsOption {
+	return func(cfg *addCIDsConfig) {
+		cfg.gatewayURL = gatewayURL
+	}
+}
+
+// AddCIDs submits an addCIDs transaction for tokenID/owner and waits for it
+// to be mined. By default cids are submitted as-is; use WithValidation,
+// WithPinning and WithGatewayCheck to guarantee that anything recorded as
+// a contribution actually resolves on IPFS.
+func (c *Client) AddCIDs(ctx context.Context, tokenID *big.Int, owner common.Address, cids []string, addCIDsOpts ...AddCIDsOption) (*types.Receipt, error) {
+	cfg := &addCIDsConfig{}
+	for _, o := range addCIDsOpts {
+		o(cfg)
+	}
+
+	if cfg.strict {
+		if err := cid.ValidateAll(cids); err != nil {
+			return nil, fmt.Errorf("pantheon: addCIDs: %w", err)
+		}
+	}
+
+	if cfg.pinAPI != "" {
+		for _, c := range cids {
+			if err := cid.Pin(ctx, cfg.pinAPI, c); err != nil {
+				return nil, fmt.Errorf("pantheon: addCIDs: %w", err)
+			}
+		}
+	}
+
+	if cfg.gatewayURL != "" {
+		for _, c := range cids {
+			ok, err := cid.Available(ctx, cfg.gatewayURL, c)
+			if err != nil {
+				return nil, fmt.Errorf("pantheon: addCIDs: %w", err)
+			}
+			if !ok {
+				return nil, fmt.Errorf("pantheon: addCIDs: CID %s not available on gateway %s", c, cfg.gatewayURL)
+			}
+		}
+	}
+
+	opts, err := c.transactOpts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pantheon: addCIDs: %w", err)
+	}
+
+	tx, err := c.contract.AddCIDs(opts, tokenID, owner, cids)
+	if err != nil {
+		c.releaseOpts(opts)
+		return nil, fmt.Errorf("pantheon: addCIDs: %w", err)
+	}
+
+	receipt, err := c.waitMined(ctx, tx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("pantheon: wait for addCIDs: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// WhitelistAdd submits an addToWhitelist transaction for addr and waits for
+// it to be mined.
+func (c *Client) WhitelistAdd(ctx context.Context, addr common.Address) (*types.Receipt, error) {
+	opts, err := c.transactOpts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pantheon: addToWhitelist: %w", err)
+	}
+
+	tx, err := c.contract.AddToWhitelist(opts, addr)
+	if err != nil {
+		c.releaseOpts(opts)
+		return nil, fmt.Errorf("pantheon: addToWhitelist: %w", err)
+	}
+
+	receipt, err := c.waitMined(ctx, tx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("pantheon: wait for addToWhitelist: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// IsWhitelisted reports whether addr is currently whitelisted.
+func (c *Client) IsWhitelisted(addr common.Address) (bool, error) {
+	return c.contract.IsWhitelisted(&bind.CallOpts{}, addr)
+}
+
+// GetContribution returns the stored contribution amount for tokenID/owner.
+func (c *Client) GetContribution(tokenID *big.Int, owner common.Address) (*big.Int, error) {
+	return c.contract.GetContribution(&bind.CallOpts{}, tokenID, owner)
+}
+
+// GetCIDs returns the CIDs stored for tokenID/owner.
+func (c *Client) GetCIDs(tokenID *big.Int, owner common.Address) ([]string, error) {
+	return c.contract.GetCIDs(&bind.CallOpts{}, tokenID, owner)
+}
+
+// findERC404Created scans a receipt's logs for an ERC404Created event,
+// rather than requiring callers to run a FilterLogs range over
+// blockNumber..blockNumber as before.
+func findERC404Created(contract *Pantheon, receipt *types.Receipt) (*PantheonERC404Created, error) {
+	for _, log := range receipt.Logs {
+		event, err := contract.ParseERC404Created(*log)
+		if err != nil {
+			continue
+		}
+		return event, nil
+	}
+
+	return nil, fmt.Errorf("pantheon: ERC404Created event not found in receipt")
+}
@@ -0,0 +1,35 @@
+package pantheonmock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+
+	"github.com/pantheons-ai/sdk-go/pkg/pantheon"
+)
+
+func TestMockAPISatisfiesInterface(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	addr := common.HexToAddress("0x00000000000000000000000000000000000ccc")
+	mock := NewMockAPI(ctrl)
+	mock.EXPECT().WhitelistAdd(gomock.Any(), addr).Return(nil, nil)
+	mock.EXPECT().IsWhitelisted(addr).Return(true, nil)
+
+	var api pantheon.API = mock
+
+	if _, err := api.WhitelistAdd(context.Background(), addr); err != nil {
+		t.Fatalf("WhitelistAdd: %v", err)
+	}
+
+	whitelisted, err := api.IsWhitelisted(addr)
+	if err != nil {
+		t.Fatalf("IsWhitelisted: %v", err)
+	}
+	if !whitelisted {
+		t.Fatalf("IsWhitelisted = false, want true")
+	}
+}
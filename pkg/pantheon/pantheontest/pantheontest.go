@@ -0,0 +1,160 @@
+// Package pantheontest spins up a simulated-chain test harness for code
+// that uses the pantheon SDK, so it can be unit tested without a live RPC
+// node or a deployed contract.
+package pantheontest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/pantheons-ai/sdk-go/pkg/pantheon"
+)
+
+const (
+	defaultGasLimit = uint64(8_000_000)
+	// defaultFundedWei is 1000 ETH, in wei.
+	defaultFundedWei = "1000000000000000000000"
+	// defaultCommitInterval is how often the harness seals pending
+	// transactions into a new block in the background. A
+	// *backends.SimulatedBackend only advances on an explicit Commit, so
+	// without this, any pantheon.Client call that waits for a receipt
+	// (as it would against a live chain) would block forever.
+	defaultCommitInterval = 50 * time.Millisecond
+)
+
+// Harness wraps a *backends.SimulatedBackend with a deployed Pantheon
+// contract and a ready-to-use *pantheon.Client signing as the funded
+// deploy key.
+type Harness struct {
+	Backend *backends.SimulatedBackend
+	Client  *pantheon.Client
+
+	deployKey *ecdsa.PrivateKey
+	chainID   *big.Int
+	stop      chan struct{}
+}
+
+// New deploys a fresh Pantheon contract to a new SimulatedBackend funded
+// with one test key, and returns a Harness around it.
+func New() (*Harness, error) {
+	deployKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, fmt.Errorf("pantheontest: generate deploy key: %w", err)
+	}
+
+	fundedWei, ok := new(big.Int).SetString(defaultFundedWei, 10)
+	if !ok {
+		return nil, fmt.Errorf("pantheontest: parse funded balance")
+	}
+
+	deployAddr := crypto.PubkeyToAddress(deployKey.PublicKey)
+	alloc := core.GenesisAlloc{deployAddr: {Balance: fundedWei}}
+	backend := backends.NewSimulatedBackend(alloc, defaultGasLimit)
+
+	ctx := context.Background()
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pantheontest: fetch chain id: %w", err)
+	}
+
+	auth, err := bind.NewKeyedTransactorWithChainID(deployKey, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("pantheontest: create deploy transactor: %w", err)
+	}
+
+	contractAddress, deployTx, _, err := pantheon.DeployPantheon(auth, backend)
+	if err != nil {
+		return nil, fmt.Errorf("pantheontest: deploy Pantheon: %w", err)
+	}
+	backend.Commit()
+
+	if _, err := bind.WaitMined(ctx, backend, deployTx); err != nil {
+		return nil, fmt.Errorf("pantheontest: wait for Pantheon deployment: %w", err)
+	}
+
+	client, err := pantheon.NewClientWithBackend(backend, contractAddress, auth)
+	if err != nil {
+		return nil, fmt.Errorf("pantheontest: build pantheon client: %w", err)
+	}
+
+	h := &Harness{Backend: backend, Client: client, deployKey: deployKey, chainID: chainID, stop: make(chan struct{})}
+	go h.autoCommit()
+
+	return h, nil
+}
+
+// autoCommit seals pending transactions into a new block every
+// defaultCommitInterval until Close is called.
+func (h *Harness) autoCommit() {
+	ticker := time.NewTicker(defaultCommitInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case <-ticker.C:
+			h.Backend.Commit()
+		}
+	}
+}
+
+// Close stops the harness's background block production. Callers should
+// defer it once the harness is no longer needed.
+func (h *Harness) Close() {
+	close(h.stop)
+	h.Client.Close()
+}
+
+// Commit seals the pending block, mining any submitted transactions.
+func (h *Harness) Commit() {
+	h.Backend.Commit()
+}
+
+// MineBlocks commits n empty blocks, useful for advancing past
+// confirmation or finality windows in tests.
+func (h *Harness) MineBlocks(n int) {
+	for i := 0; i < n; i++ {
+		h.Backend.Commit()
+	}
+}
+
+// Fund sends wei from the harness's funded deploy key to addr and commits
+// the transfer.
+func (h *Harness) Fund(addr common.Address, wei *big.Int) error {
+	ctx := context.Background()
+	from := crypto.PubkeyToAddress(h.deployKey.PublicKey)
+
+	nonce, err := h.Backend.PendingNonceAt(ctx, from)
+	if err != nil {
+		return fmt.Errorf("pantheontest: fetch nonce: %w", err)
+	}
+
+	gasPrice, err := h.Backend.SuggestGasPrice(ctx)
+	if err != nil {
+		return fmt.Errorf("pantheontest: suggest gas price: %w", err)
+	}
+
+	tx := types.NewTransaction(nonce, addr, wei, 21000, gasPrice, nil)
+	signedTx, err := types.SignTx(tx, types.NewEIP155Signer(h.chainID), h.deployKey)
+	if err != nil {
+		return fmt.Errorf("pantheontest: sign funding tx: %w", err)
+	}
+
+	if err := h.Backend.SendTransaction(ctx, signedTx); err != nil {
+		return fmt.Errorf("pantheontest: send funding tx: %w", err)
+	}
+	h.Backend.Commit()
+
+	return nil
+}
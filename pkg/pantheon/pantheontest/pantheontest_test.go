@@ -0,0 +1,95 @@
+package pantheontest
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/pantheons-ai/sdk-go/pkg/pantheon"
+)
+
+func TestHarnessEndToEnd(t *testing.T) {
+	h, err := New()
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer h.Close()
+
+	owner := common.HexToAddress("0x00000000000000000000000000000000000aaa")
+	if err := h.Fund(owner, big.NewInt(1_000_000_000_000_000_000)); err != nil {
+		t.Fatalf("Fund: %v", err)
+	}
+
+	ctx := context.Background()
+
+	if _, err := h.Client.WhitelistAdd(ctx, owner); err != nil {
+		t.Fatalf("WhitelistAdd: %v", err)
+	}
+
+	whitelisted, err := h.Client.IsWhitelisted(owner)
+	if err != nil {
+		t.Fatalf("IsWhitelisted: %v", err)
+	}
+	if !whitelisted {
+		t.Fatalf("IsWhitelisted(%s) = false, want true after WhitelistAdd", owner.Hex())
+	}
+
+	event, _, err := h.Client.CreateERC404(ctx, pantheon.CreateERC404Params{
+		Name:     "Pantheon Test Token",
+		Symbol:   "PTT",
+		Decimals: 18,
+		Supply:   big.NewInt(1_000_000),
+		Owner:    owner,
+	})
+	if err != nil {
+		t.Fatalf("CreateERC404: %v", err)
+	}
+
+	wantCIDs := []string{
+		"QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG",
+		"bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+	}
+	receipt, err := h.Client.AddCIDs(ctx, event.Id, owner, wantCIDs)
+	if err != nil {
+		t.Fatalf("AddCIDs: %v", err)
+	}
+
+	filterer, err := pantheon.NewPantheonFilterer(h.Client.Address(), h.Backend)
+	if err != nil {
+		t.Fatalf("NewPantheonFilterer: %v", err)
+	}
+	var cidsAdded *pantheon.PantheonCIDsAdded
+	for _, log := range receipt.Logs {
+		if evt, err := filterer.ParseCIDsAdded(*log); err == nil {
+			cidsAdded = evt
+			break
+		}
+	}
+	if cidsAdded == nil {
+		t.Fatalf("AddCIDs receipt has no CIDsAdded log: %v", receipt.Logs)
+	}
+	if !reflect.DeepEqual(cidsAdded.Cids, wantCIDs) {
+		t.Fatalf("CIDsAdded.Cids = %v, want %v", cidsAdded.Cids, wantCIDs)
+	}
+
+	contribution, err := h.Client.GetContribution(event.Id, owner)
+	if err != nil {
+		t.Fatalf("GetContribution: %v", err)
+	}
+	if contribution.Cmp(big.NewInt(2)) != 0 {
+		t.Fatalf("GetContribution(%s, %s) = %s, want 2", event.Id, owner.Hex(), contribution)
+	}
+
+	gotCIDs, err := h.Client.GetCIDs(event.Id, owner)
+	if err != nil {
+		t.Fatalf("GetCIDs: %v", err)
+	}
+	if !reflect.DeepEqual(gotCIDs, wantCIDs) {
+		t.Fatalf("GetCIDs(%s, %s) = %v, want %v", event.Id, owner.Hex(), gotCIDs, wantCIDs)
+	}
+
+	h.MineBlocks(2)
+}
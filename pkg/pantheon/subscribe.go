@@ -0,0 +1,133 @@
+package pantheon
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// resubscribeBackoffMax is the ceiling event.ResubscribeErr backs off to.
+// It starts retries at resubscribeBackoffMax/10 and doubles on each
+// consecutive failure up to this value, so a sustained outage settles
+// into reconnect attempts a minute apart instead of hammering the node.
+const resubscribeBackoffMax = 1 * time.Minute
+
+// SubscriptionClient streams Pantheon events over a WebSocket connection,
+// as opposed to Client which sends transactions and makes calls over HTTP.
+type SubscriptionClient struct {
+	wsURL   string
+	address common.Address
+}
+
+// NewSubscriptionClient returns a SubscriptionClient that will dial wsURL
+// and bind to the Pantheon contract at contractAddress on each
+// (re)subscription.
+func NewSubscriptionClient(wsURL string, contractAddress common.Address) *SubscriptionClient {
+	return &SubscriptionClient{wsURL: wsURL, address: contractAddress}
+}
+
+// SubscribeERC404Created streams ERC404Created events into sink. The
+// returned subscription automatically redials and resubscribes with
+// exponential backoff if the node drops the WebSocket connection.
+func (s *SubscriptionClient) SubscribeERC404Created(ctx context.Context, sink chan<- *PantheonERC404Created) (event.Subscription, error) {
+	return s.resubscribe(ctx, func(ctx context.Context, contract *Pantheon) (event.Subscription, error) {
+		return contract.WatchERC404Created(&bind.WatchOpts{Context: ctx}, sink, nil, nil)
+	})
+}
+
+// SubscribeWhitelistAdded streams WhitelistAdded events into sink, with the
+// same reconnect behaviour as SubscribeERC404Created.
+func (s *SubscriptionClient) SubscribeWhitelistAdded(ctx context.Context, sink chan<- *PantheonWhitelistAdded) (event.Subscription, error) {
+	return s.resubscribe(ctx, func(ctx context.Context, contract *Pantheon) (event.Subscription, error) {
+		return contract.WatchWhitelistAdded(&bind.WatchOpts{Context: ctx}, sink, nil)
+	})
+}
+
+// SubscribeCIDsAdded streams CIDsAdded events into sink, with the same
+// reconnect behaviour as SubscribeERC404Created.
+func (s *SubscriptionClient) SubscribeCIDsAdded(ctx context.Context, sink chan<- *PantheonCIDsAdded) (event.Subscription, error) {
+	return s.resubscribe(ctx, func(ctx context.Context, contract *Pantheon) (event.Subscription, error) {
+		return contract.WatchCIDsAdded(&bind.WatchOpts{Context: ctx}, sink, nil, nil)
+	})
+}
+
+// resubscribe wraps watch in event.ResubscribeErr so that a dropped
+// WebSocket connection results in a fresh dial, a fresh contract binding
+// and a fresh Watch* call rather than a dead subscription.
+//
+// event.ResubscribeErr drives its retry loop off its own internal
+// context, not ctx, so ctx is threaded through by hand: each dial/watch
+// attempt runs under a context that's cancelled the moment either the
+// retry loop's own context or ctx is done, and a second goroutine calls
+// Unsubscribe once ctx is done so a caller cancelling ctx actually tears
+// the subscription down instead of leaving it running unobserved.
+func (s *SubscriptionClient) resubscribe(ctx context.Context, watch func(ctx context.Context, contract *Pantheon) (event.Subscription, error)) (event.Subscription, error) {
+	sub := event.ResubscribeErr(resubscribeBackoffMax, func(retryCtx context.Context, lastErr error) (event.Subscription, error) {
+		attemptCtx, cancel := context.WithCancel(retryCtx)
+		defer cancel()
+		go func() {
+			select {
+			case <-ctx.Done():
+				cancel()
+			case <-attemptCtx.Done():
+			}
+		}()
+		return s.dialAndWatch(attemptCtx, watch)
+	})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			sub.Unsubscribe()
+		case <-sub.Err():
+		}
+	}()
+
+	return sub, nil
+}
+
+// dialAndWatch makes one attempt at dialing s.wsURL, binding the Pantheon
+// contract and starting watch. It's split out from resubscribe so this
+// attempt can be unit tested directly, without driving
+// event.ResubscribeErr's backoff loop to observe a single dial or bind
+// failure.
+func (s *SubscriptionClient) dialAndWatch(ctx context.Context, watch func(ctx context.Context, contract *Pantheon) (event.Subscription, error)) (event.Subscription, error) {
+	eth, err := ethclient.DialContext(ctx, s.wsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	contract, err := NewPantheon(s.address, eth)
+	if err != nil {
+		eth.Close()
+		return nil, err
+	}
+
+	sub, err := watch(ctx, contract)
+	if err != nil {
+		eth.Close()
+		return nil, err
+	}
+
+	return &wsSubscription{sub: sub, eth: eth}, nil
+}
+
+// wsSubscription closes the underlying WebSocket connection along with the
+// watch subscription, so resubscribing doesn't leak connections.
+type wsSubscription struct {
+	sub event.Subscription
+	eth *ethclient.Client
+}
+
+func (w *wsSubscription) Unsubscribe() {
+	w.sub.Unsubscribe()
+	w.eth.Close()
+}
+
+func (w *wsSubscription) Err() <-chan error {
+	return w.sub.Err()
+}
@@ -0,0 +1,90 @@
+package pantheon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/gorilla/websocket"
+)
+
+// TestDialAndWatchContractBindFailure must run before any test in this
+// package successfully parses PantheonMetaData.ABI: bind.MetaData.GetAbi
+// caches the first parse and ignores ABI edits afterwards, which would
+// mask the failure this test corrupts the ABI to trigger.
+func TestDialAndWatchContractBindFailure(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http")
+
+	originalABI := PantheonMetaData.ABI
+	PantheonMetaData.ABI = "not valid json"
+	defer func() { PantheonMetaData.ABI = originalABI }()
+
+	s := NewSubscriptionClient(wsURL, common.Address{})
+	watch := func(ctx context.Context, contract *Pantheon) (event.Subscription, error) {
+		t.Fatal("watch called after contract binding should have failed")
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := s.dialAndWatch(ctx, watch); err == nil {
+		t.Fatal("dialAndWatch() with an invalid ABI = nil error, want a bind error")
+	}
+}
+
+func TestDialAndWatchDialFailure(t *testing.T) {
+	// Port 0 never has a listener, so the dial fails immediately without
+	// needing a live node or even a reachable address.
+	s := NewSubscriptionClient("ws://127.0.0.1:0", common.Address{})
+	watch := func(ctx context.Context, contract *Pantheon) (event.Subscription, error) {
+		t.Fatal("watch called after dial should have failed")
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := s.dialAndWatch(ctx, watch); err == nil {
+		t.Fatal("dialAndWatch() with an unreachable wsURL = nil error, want a dial error")
+	}
+}
+
+func TestResubscribeStopsOnContextCancel(t *testing.T) {
+	// Port 0 never has a listener, so resubscribe sits in its dial-retry
+	// backoff loop until the context below is cancelled.
+	s := NewSubscriptionClient("ws://127.0.0.1:0", common.Address{})
+	watch := func(ctx context.Context, contract *Pantheon) (event.Subscription, error) {
+		t.Fatal("watch called after dial should have failed")
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := s.resubscribe(ctx, watch)
+	if err != nil {
+		t.Fatalf("resubscribe() error = %v", err)
+	}
+	cancel()
+
+	select {
+	case <-sub.Err():
+	case <-time.After(5 * time.Second):
+		t.Fatal("resubscribe subscription outlived its cancelled context")
+	}
+}
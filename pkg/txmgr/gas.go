@@ -0,0 +1,105 @@
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+)
+
+// GasPrice carries either a legacy gas price or an EIP-1559 fee/tip pair.
+// Exactly one of GasPrice or (GasFeeCap, GasTipCap) should be set.
+type GasPrice struct {
+	GasPrice  *big.Int
+	GasFeeCap *big.Int
+	GasTipCap *big.Int
+}
+
+// GasPolicy suggests gas parameters for a new transaction.
+type GasPolicy interface {
+	SuggestGas(ctx context.Context, eth Backend) (*GasPrice, error)
+}
+
+// LegacyGasPolicy uses eth_gasPrice, for chains that don't support
+// EIP-1559.
+type LegacyGasPolicy struct{}
+
+func (LegacyGasPolicy) SuggestGas(ctx context.Context, eth Backend) (*GasPrice, error) {
+	price, err := eth.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("txmgr: suggest gas price: %w", err)
+	}
+
+	return &GasPrice{GasPrice: price}, nil
+}
+
+// DynamicFeeGasPolicy builds an EIP-1559 fee cap from the chain's suggested
+// priority tip and the latest block's base fee, multiplied by
+// BaseFeeMultiplier to leave headroom for the fee to rise before
+// inclusion. A multiplier <= 0 defaults to 2.
+type DynamicFeeGasPolicy struct {
+	BaseFeeMultiplier float64
+}
+
+func (p DynamicFeeGasPolicy) SuggestGas(ctx context.Context, eth Backend) (*GasPrice, error) {
+	multiplier := p.BaseFeeMultiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	tipCap, err := eth.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("txmgr: suggest gas tip cap: %w", err)
+	}
+
+	head, err := eth.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("txmgr: fetch latest header: %w", err)
+	}
+	if head.BaseFee == nil {
+		return nil, fmt.Errorf("txmgr: chain does not report a base fee, use LegacyGasPolicy instead")
+	}
+
+	feeCap := new(big.Float).Mul(new(big.Float).SetInt(head.BaseFee), big.NewFloat(multiplier))
+	feeCapInt, _ := feeCap.Int(nil)
+	feeCapInt.Add(feeCapInt, tipCap)
+
+	return &GasPrice{GasFeeCap: feeCapInt, GasTipCap: tipCap}, nil
+}
+
+// OracleGasPolicy defers to a user-supplied function, for callers with
+// their own gas estimation service.
+type OracleGasPolicy struct {
+	Oracle func(ctx context.Context) (*GasPrice, error)
+}
+
+func (p OracleGasPolicy) SuggestGas(ctx context.Context, _ Backend) (*GasPrice, error) {
+	return p.Oracle(ctx)
+}
+
+// bump scales up a gas price by multiplier, used to rebroadcast a stuck
+// transaction. A multiplier <= 1 is treated as 1.1 (a 10% bump), which is
+// the minimum most nodes require to accept a replacement.
+func bump(price *GasPrice, multiplier float64) *GasPrice {
+	if multiplier <= 1 {
+		multiplier = 1.1
+	}
+
+	bumped := &GasPrice{}
+	if price.GasPrice != nil {
+		bumped.GasPrice = mulFloat(price.GasPrice, multiplier)
+	}
+	if price.GasFeeCap != nil {
+		bumped.GasFeeCap = mulFloat(price.GasFeeCap, multiplier)
+	}
+	if price.GasTipCap != nil {
+		bumped.GasTipCap = mulFloat(price.GasTipCap, multiplier)
+	}
+
+	return bumped
+}
+
+func mulFloat(v *big.Int, multiplier float64) *big.Int {
+	f := new(big.Float).Mul(new(big.Float).SetInt(v), big.NewFloat(multiplier))
+	out, _ := f.Int(nil)
+	return out
+}
@@ -0,0 +1,263 @@
+// Package txmgr manages transaction nonces and gas pricing for callers
+// that submit transactions concurrently or need stuck transactions to be
+// bumped and rebroadcast automatically.
+package txmgr
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	defaultStuckTimeout   = 2 * time.Minute
+	defaultBumpMultiplier = 1.2
+	defaultPollInterval   = 3 * time.Second
+)
+
+// Backend is the subset of *ethclient.Client that Manager needs: nonce and
+// gas suggestions, sending raw transactions, and polling for receipts. A
+// *backends.SimulatedBackend satisfies it too.
+type Backend interface {
+	bind.ContractTransactor
+	bind.DeployBackend
+
+	// HeaderByNumber is needed by DynamicFeeGasPolicy to read the
+	// latest base fee; spelled out explicitly since it isn't part of
+	// every ContractTransactor implementation's embedded interfaces.
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Manager tracks per-account nonces locally and applies a GasPolicy to new
+// transactions, bumping and rebroadcasting them if they aren't mined
+// within StuckTimeout.
+type Manager struct {
+	eth    Backend
+	policy GasPolicy
+
+	StuckTimeout   time.Duration
+	BumpMultiplier float64
+	PollInterval   time.Duration
+
+	mu     sync.Mutex
+	nonces map[common.Address]*uint64
+	free   map[common.Address]map[uint64]struct{}
+}
+
+// NewManager returns a Manager that reads pending nonces and suggested gas
+// prices from eth, using policy to price new transactions.
+func NewManager(eth Backend, policy GasPolicy) *Manager {
+	return &Manager{
+		eth:            eth,
+		policy:         policy,
+		StuckTimeout:   defaultStuckTimeout,
+		BumpMultiplier: defaultBumpMultiplier,
+		PollInterval:   defaultPollInterval,
+		nonces:         make(map[common.Address]*uint64),
+		free:           make(map[common.Address]map[uint64]struct{}),
+	}
+}
+
+// PrepareOpts returns a copy of auth with a reserved nonce and a gas price
+// from the configured GasPolicy, ready to sign and submit exactly one
+// transaction. Call Release if the transaction is never broadcast, so the
+// reserved nonce isn't stranded.
+func (m *Manager) PrepareOpts(ctx context.Context, auth *bind.TransactOpts) (*bind.TransactOpts, error) {
+	nonce, err := m.reserveNonce(ctx, auth.From)
+	if err != nil {
+		return nil, err
+	}
+
+	gas, err := m.policy.SuggestGas(ctx, m.eth)
+	if err != nil {
+		m.Release(auth.From, nonce)
+		return nil, err
+	}
+
+	opts := *auth
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	opts.GasPrice = gas.GasPrice
+	opts.GasFeeCap = gas.GasFeeCap
+	opts.GasTipCap = gas.GasTipCap
+
+	return &opts, nil
+}
+
+// Release returns a reserved nonce to the pool. Safe to call after a
+// transaction built from PrepareOpts's result failed before it was ever
+// broadcast. The nonce is recorded as free regardless of whether it was
+// the most recently reserved one, so a failure under concurrent
+// submission doesn't strand every nonce reserved after it.
+func (m *Manager) Release(addr common.Address, nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next := m.nonces[addr]
+	if next == nil || nonce >= *next {
+		return
+	}
+
+	if *next == nonce+1 {
+		*next = nonce
+		return
+	}
+
+	if m.free == nil {
+		m.free = make(map[common.Address]map[uint64]struct{})
+	}
+	free := m.free[addr]
+	if free == nil {
+		free = make(map[uint64]struct{})
+		m.free[addr] = free
+	}
+	free[nonce] = struct{}{}
+}
+
+// reserveNonce returns the next nonce to use for addr, bootstrapping from
+// PendingNonceAt the first time addr is seen. It prefers reusing the
+// lowest nonce released by Release over advancing the counter, so gaps
+// left by failed reservations get filled instead of growing unbounded.
+func (m *Manager) reserveNonce(ctx context.Context, addr common.Address) (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	next := m.nonces[addr]
+	if next == nil {
+		pending, err := m.eth.PendingNonceAt(ctx, addr)
+		if err != nil {
+			return 0, fmt.Errorf("txmgr: bootstrap nonce for %s: %w", addr.Hex(), err)
+		}
+		next = new(uint64)
+		*next = pending
+		m.nonces[addr] = next
+	}
+
+	if free := m.free[addr]; len(free) > 0 {
+		nonces := make([]uint64, 0, len(free))
+		for n := range free {
+			nonces = append(nonces, n)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+		nonce := nonces[0]
+		delete(free, nonce)
+		return nonce, nil
+	}
+
+	nonce := *next
+	*next++
+	return nonce, nil
+}
+
+// Wait waits for tx to be mined. If it isn't mined within m.StuckTimeout,
+// Wait rebuilds it with a bumped gas price using the same nonce, re-signs
+// it via auth.Signer, rebroadcasts it, and keeps waiting. It repeats this
+// until the transaction (original or a bumped replacement) is mined or ctx
+// is cancelled.
+func (m *Manager) Wait(ctx context.Context, tx *types.Transaction, auth *bind.TransactOpts) (*types.Receipt, error) {
+	current := tx
+	// replaced holds every earlier broadcast at this nonce; any of them
+	// can still be the one that gets mined.
+	var replaced []*types.Transaction
+
+	for {
+		receipt, err := waitMinedOrTimeout(ctx, m.eth, current, m.StuckTimeout, m.PollInterval)
+		if err == nil {
+			return receipt, nil
+		}
+		if err != errStuck {
+			return nil, err
+		}
+
+		for _, prev := range replaced {
+			if receipt, err := m.eth.TransactionReceipt(ctx, prev.Hash()); err == nil {
+				return receipt, nil
+			}
+		}
+
+		bumped, err := m.bumpAndResend(ctx, current, auth)
+		if err != nil {
+			return nil, fmt.Errorf("txmgr: rebroadcast stuck tx %s: %w", current.Hash().Hex(), err)
+		}
+		replaced = append(replaced, current)
+		current = bumped
+	}
+}
+
+var errStuck = fmt.Errorf("txmgr: transaction not mined within timeout")
+
+// waitMinedOrTimeout is bind.WaitMined with a bound on how long it will
+// poll before giving up and reporting the tx as stuck.
+func waitMinedOrTimeout(ctx context.Context, eth Backend, tx *types.Transaction, timeout, pollInterval time.Duration) (*types.Receipt, error) {
+	deadline := time.Now().Add(timeout)
+	queryTicker := time.NewTicker(pollInterval)
+	defer queryTicker.Stop()
+
+	for {
+		receipt, err := eth.TransactionReceipt(ctx, tx.Hash())
+		if err == nil {
+			return receipt, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, errStuck
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-queryTicker.C:
+		}
+	}
+}
+
+// bumpAndResend rebuilds tx with a higher gas price at the same nonce,
+// re-signs it, and submits it.
+func (m *Manager) bumpAndResend(ctx context.Context, tx *types.Transaction, auth *bind.TransactOpts) (*types.Transaction, error) {
+	current := &GasPrice{
+		GasPrice:  tx.GasPrice(),
+		GasFeeCap: tx.GasFeeCap(),
+		GasTipCap: tx.GasTipCap(),
+	}
+	next := bump(current, m.BumpMultiplier)
+
+	var replacement *types.Transaction
+	if tx.Type() == types.DynamicFeeTxType {
+		replacement = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   tx.ChainId(),
+			Nonce:     tx.Nonce(),
+			GasTipCap: next.GasTipCap,
+			GasFeeCap: next.GasFeeCap,
+			Gas:       tx.Gas(),
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		})
+	} else {
+		replacement = types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: next.GasPrice,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		})
+	}
+
+	signed, err := auth.Signer(auth.From, replacement)
+	if err != nil {
+		return nil, fmt.Errorf("sign replacement: %w", err)
+	}
+
+	if err := m.eth.SendTransaction(ctx, signed); err != nil {
+		return nil, fmt.Errorf("send replacement: %w", err)
+	}
+
+	return signed, nil
+}
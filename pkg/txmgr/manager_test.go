@@ -0,0 +1,153 @@
+package txmgr
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestBump(t *testing.T) {
+	cases := []struct {
+		name       string
+		price      *GasPrice
+		multiplier float64
+		want       *GasPrice
+	}{
+		{
+			name:       "legacy gas price bumped by an explicit multiplier",
+			price:      &GasPrice{GasPrice: big.NewInt(100)},
+			multiplier: 1.5,
+			want:       &GasPrice{GasPrice: big.NewInt(150)},
+		},
+		{
+			name:       "multiplier <= 1 defaults to a 10% bump",
+			price:      &GasPrice{GasPrice: big.NewInt(100)},
+			multiplier: 1,
+			want:       &GasPrice{GasPrice: big.NewInt(110)},
+		},
+		{
+			name:       "dynamic fee fields are bumped independently",
+			price:      &GasPrice{GasFeeCap: big.NewInt(200), GasTipCap: big.NewInt(20)},
+			multiplier: 2,
+			want:       &GasPrice{GasFeeCap: big.NewInt(400), GasTipCap: big.NewInt(40)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := bump(tc.price, tc.multiplier)
+			assertBigIntEqual(t, "GasPrice", got.GasPrice, tc.want.GasPrice)
+			assertBigIntEqual(t, "GasFeeCap", got.GasFeeCap, tc.want.GasFeeCap)
+			assertBigIntEqual(t, "GasTipCap", got.GasTipCap, tc.want.GasTipCap)
+		})
+	}
+}
+
+func assertBigIntEqual(t *testing.T, field string, got, want *big.Int) {
+	t.Helper()
+
+	if (got == nil) != (want == nil) {
+		t.Errorf("%s = %v, want %v", field, got, want)
+		return
+	}
+	if got != nil && got.Cmp(want) != 0 {
+		t.Errorf("%s = %s, want %s", field, got, want)
+	}
+}
+
+func TestManagerReserveAndReleaseNonce(t *testing.T) {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000a")
+	m := &Manager{nonces: make(map[common.Address]*uint64), eth: fakePendingNonceBackend{pending: 5}}
+
+	first, err := m.reserveNonce(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserveNonce: %v", err)
+	}
+	if first != 5 {
+		t.Fatalf("first nonce = %d, want 5 (bootstrapped from PendingNonceAt)", first)
+	}
+
+	second, err := m.reserveNonce(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserveNonce: %v", err)
+	}
+	if second != 6 {
+		t.Fatalf("second nonce = %d, want 6", second)
+	}
+
+	m.Release(addr, second)
+
+	third, err := m.reserveNonce(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserveNonce: %v", err)
+	}
+	if third != second {
+		t.Fatalf("nonce after release = %d, want the released nonce %d to be reused", third, second)
+	}
+}
+
+func TestManagerReleaseIgnoresUnreservedNonce(t *testing.T) {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000b")
+	m := &Manager{nonces: make(map[common.Address]*uint64), eth: fakePendingNonceBackend{pending: 0}}
+
+	if _, err := m.reserveNonce(context.Background(), addr); err != nil {
+		t.Fatalf("reserveNonce: %v", err)
+	}
+
+	// Nonce 99 is at or past the counter (next is 1), so it was never
+	// reserved. Releasing it must change neither the counter nor the free
+	// set, or a later caller could be handed nonce 99.
+	m.Release(addr, 99)
+
+	next, err := m.reserveNonce(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserveNonce: %v", err)
+	}
+	if next != 1 {
+		t.Fatalf("nonce after releasing an unreserved nonce = %d, want 1 (release of a nonce at or past the counter should be a no-op)", next)
+	}
+}
+
+func TestManagerReleaseReclaimsNonSequentialNonce(t *testing.T) {
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000c")
+	m := &Manager{nonces: make(map[common.Address]*uint64), eth: fakePendingNonceBackend{pending: 0}}
+
+	for i := 0; i < 3; i++ {
+		if _, err := m.reserveNonce(context.Background(), addr); err != nil {
+			t.Fatalf("reserveNonce: %v", err)
+		}
+	}
+	// Reserved nonces 0, 1, 2; next would be 3. Release nonce 1, which
+	// isn't the most recently reserved one (2 is) - a submission for it
+	// failed before broadcast while nonces 0 and 2 are still in flight.
+	m.Release(addr, 1)
+
+	reused, err := m.reserveNonce(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserveNonce: %v", err)
+	}
+	if reused != 1 {
+		t.Fatalf("nonce after releasing a non-sequential reservation = %d, want the released nonce 1 reused instead of stranded", reused)
+	}
+
+	next, err := m.reserveNonce(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserveNonce: %v", err)
+	}
+	if next != 3 {
+		t.Fatalf("nonce after the free set is drained = %d, want 3 (the counter should resume where it left off)", next)
+	}
+}
+
+// fakePendingNonceBackend implements just enough of Backend to bootstrap a
+// Manager's nonce tracking in tests; any other method panics if called.
+type fakePendingNonceBackend struct {
+	Backend
+	pending uint64
+}
+
+func (f fakePendingNonceBackend) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return f.pending, nil
+}
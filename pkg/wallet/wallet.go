@@ -0,0 +1,150 @@
+// Package wallet builds transaction signers for the pantheons SDK. It
+// supports go-ethereum V3 keystore files, BIP39/BIP44 mnemonic derivation
+// and a clef/external-signer backend, so callers no longer need to carry
+// raw hex private keys in config files.
+package wallet
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	hdwallet "github.com/miguelmota/go-ethereum-hdwallet"
+
+	"github.com/pantheons-ai/sdk-go/config"
+)
+
+// DefaultDerivationPath is used when a mnemonic signer config omits one.
+const DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+// NewTransactor builds a *bind.TransactOpts for the backend selected by
+// cfg.Signer.Type, along with the resolved signing address. If Signer.Type
+// is empty it falls back to the legacy plaintext cfg.PrivateKey for
+// backwards compatibility.
+func NewTransactor(cfg *config.Config, chainID *big.Int) (*bind.TransactOpts, common.Address, error) {
+	signerType := cfg.Signer.Type
+	if signerType == "" && cfg.PrivateKey != "" {
+		signerType = "privatekey"
+	}
+
+	switch signerType {
+	case "privatekey":
+		return transactorFromPrivateKey(cfg, chainID)
+	case "keystore":
+		return transactorFromKeystore(cfg, chainID)
+	case "mnemonic":
+		return transactorFromMnemonic(cfg, chainID)
+	case "clef":
+		return transactorFromClef(cfg, chainID)
+	default:
+		return nil, common.Address{}, fmt.Errorf("wallet: unknown signer type %q", signerType)
+	}
+}
+
+func transactorFromPrivateKey(cfg *config.Config, chainID *big.Int) (*bind.TransactOpts, common.Address, error) {
+	hexKey := cfg.Signer.PrivateKey
+	if hexKey == "" {
+		hexKey = cfg.PrivateKey
+	}
+
+	privateKey, err := crypto.HexToECDSA(hexKey)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: load private key: %w", err)
+	}
+
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: create transactor: %w", err)
+	}
+
+	return auth, auth.From, nil
+}
+
+func transactorFromKeystore(cfg *config.Config, chainID *big.Int) (*bind.TransactOpts, common.Address, error) {
+	if cfg.Signer.KeystorePath == "" {
+		return nil, common.Address{}, fmt.Errorf("wallet: signer.keystore_path is required for the keystore backend")
+	}
+
+	keyJSON, err := ioutil.ReadFile(cfg.Signer.KeystorePath)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: read keystore file: %w", err)
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, cfg.Signer.KeystorePassphrase)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: decrypt keystore: %w", err)
+	}
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key.PrivateKey, chainID)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: create transactor: %w", err)
+	}
+
+	return auth, auth.From, nil
+}
+
+func transactorFromMnemonic(cfg *config.Config, chainID *big.Int) (*bind.TransactOpts, common.Address, error) {
+	if cfg.Signer.Mnemonic == "" {
+		return nil, common.Address{}, fmt.Errorf("wallet: signer.mnemonic is required for the mnemonic backend")
+	}
+
+	path := cfg.Signer.DerivationPath
+	if path == "" {
+		path = DefaultDerivationPath
+	}
+
+	wallet, err := hdwallet.NewFromMnemonic(cfg.Signer.Mnemonic)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: parse mnemonic: %w", err)
+	}
+
+	derivationPath, err := hdwallet.ParseDerivationPath(path)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: parse derivation path %q: %w", path, err)
+	}
+
+	account, err := wallet.Derive(derivationPath, false)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: derive account: %w", err)
+	}
+
+	privateKey, err := wallet.PrivateKey(account)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: load derived private key: %w", err)
+	}
+
+	auth, err := bind.NewKeyedTransactorWithChainID(privateKey, chainID)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: create transactor: %w", err)
+	}
+
+	return auth, auth.From, nil
+}
+
+func transactorFromClef(cfg *config.Config, chainID *big.Int) (*bind.TransactOpts, common.Address, error) {
+	if cfg.Signer.ClefEndpoint == "" {
+		return nil, common.Address{}, fmt.Errorf("wallet: signer.clef_endpoint is required for the clef backend")
+	}
+	if cfg.Signer.ClefAccount == "" {
+		return nil, common.Address{}, fmt.Errorf("wallet: signer.clef_account is required for the clef backend")
+	}
+
+	signer, err := external.NewExternalSigner(cfg.Signer.ClefEndpoint)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf("wallet: dial clef: %w", err)
+	}
+
+	address := common.HexToAddress(cfg.Signer.ClefAccount)
+	account := accounts.Account{Address: address}
+
+	auth := bind.NewClefTransactor(signer, account)
+	auth.GasLimit = 0
+
+	return auth, address, nil
+}
@@ -0,0 +1,227 @@
+package wallet
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/pantheons-ai/sdk-go/config"
+)
+
+var testChainID = big.NewInt(1337)
+
+// testMnemonic is the well-known go-ethereum/hardhat test mnemonic; it has
+// no funds on any real network.
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func TestNewTransactorUnknownSignerType(t *testing.T) {
+	cfg := &config.Config{Signer: config.SignerConfig{Type: "carrier-pigeon"}}
+	if _, _, err := NewTransactor(cfg, testChainID); err == nil {
+		t.Fatalf("NewTransactor() with unknown signer type = nil error, want an error")
+	}
+}
+
+func TestNewTransactorDefaultsToPrivateKeyBackend(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	cfg := &config.Config{PrivateKey: hexPrivateKey(key)}
+
+	auth, addr, err := NewTransactor(cfg, testChainID)
+	if err != nil {
+		t.Fatalf("NewTransactor: %v", err)
+	}
+	if auth.From != addr {
+		t.Fatalf("auth.From = %s, want %s", auth.From, addr)
+	}
+}
+
+func TestTransactorFromPrivateKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	wantAddr := crypto.PubkeyToAddress(key.PublicKey)
+
+	cases := []struct {
+		name    string
+		hexKey  string
+		wantErr bool
+	}{
+		{name: "valid key", hexKey: hexPrivateKey(key), wantErr: false},
+		{name: "malformed hex", hexKey: "not-hex", wantErr: true},
+		{name: "empty key", hexKey: "", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{Signer: config.SignerConfig{Type: "privatekey", PrivateKey: tc.hexKey}}
+			auth, addr, err := transactorFromPrivateKey(cfg, testChainID)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("transactorFromPrivateKey() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if addr != wantAddr {
+				t.Fatalf("address = %s, want %s", addr, wantAddr)
+			}
+			if auth.From != wantAddr {
+				t.Fatalf("auth.From = %s, want %s", auth.From, wantAddr)
+			}
+		})
+	}
+}
+
+func TestTransactorFromKeystore(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	wantAddr := crypto.PubkeyToAddress(key.PublicKey)
+
+	const passphrase = "correct horse battery staple"
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{
+		Address:    wantAddr,
+		PrivateKey: key,
+	}, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("EncryptKey: %v", err)
+	}
+
+	dir := t.TempDir()
+	goodPath := filepath.Join(dir, "keystore.json")
+	if err := writeFile(goodPath, keyJSON); err != nil {
+		t.Fatalf("write keystore file: %v", err)
+	}
+
+	corruptPath := filepath.Join(dir, "corrupt.json")
+	if err := writeFile(corruptPath, []byte("not json")); err != nil {
+		t.Fatalf("write corrupt keystore file: %v", err)
+	}
+
+	cases := []struct {
+		name       string
+		path       string
+		passphrase string
+		wantErr    bool
+	}{
+		{name: "correct passphrase", path: goodPath, passphrase: passphrase, wantErr: false},
+		{name: "wrong passphrase", path: goodPath, passphrase: "not the passphrase", wantErr: true},
+		{name: "corrupt keystore JSON", path: corruptPath, passphrase: passphrase, wantErr: true},
+		{name: "missing file", path: filepath.Join(dir, "missing.json"), passphrase: passphrase, wantErr: true},
+		{name: "missing path", path: "", passphrase: passphrase, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{Signer: config.SignerConfig{
+				Type:               "keystore",
+				KeystorePath:       tc.path,
+				KeystorePassphrase: tc.passphrase,
+			}}
+			auth, addr, err := transactorFromKeystore(cfg, testChainID)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("transactorFromKeystore() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if addr != wantAddr {
+				t.Fatalf("address = %s, want %s", addr, wantAddr)
+			}
+			if auth.From != wantAddr {
+				t.Fatalf("auth.From = %s, want %s", auth.From, wantAddr)
+			}
+		})
+	}
+}
+
+func TestTransactorFromMnemonic(t *testing.T) {
+	cases := []struct {
+		name           string
+		mnemonic       string
+		derivationPath string
+		wantErr        bool
+	}{
+		{name: "valid mnemonic, default derivation path", mnemonic: testMnemonic, wantErr: false},
+		{name: "valid mnemonic, explicit derivation path", mnemonic: testMnemonic, derivationPath: "m/44'/60'/0'/0/1", wantErr: false},
+		{name: "invalid mnemonic", mnemonic: "not a real mnemonic phrase at all", wantErr: true},
+		{name: "empty mnemonic", mnemonic: "", wantErr: true},
+		{name: "malformed derivation path", mnemonic: testMnemonic, derivationPath: "not-a-path", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{Signer: config.SignerConfig{
+				Type:           "mnemonic",
+				Mnemonic:       tc.mnemonic,
+				DerivationPath: tc.derivationPath,
+			}}
+			auth, addr, err := transactorFromMnemonic(cfg, testChainID)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("transactorFromMnemonic() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if auth.From != addr {
+				t.Fatalf("auth.From = %s, want %s", auth.From, addr)
+			}
+		})
+	}
+}
+
+func TestTransactorFromMnemonicIsDeterministic(t *testing.T) {
+	cfg := &config.Config{Signer: config.SignerConfig{Type: "mnemonic", Mnemonic: testMnemonic}}
+
+	_, addr1, err := transactorFromMnemonic(cfg, testChainID)
+	if err != nil {
+		t.Fatalf("transactorFromMnemonic: %v", err)
+	}
+	_, addr2, err := transactorFromMnemonic(cfg, testChainID)
+	if err != nil {
+		t.Fatalf("transactorFromMnemonic: %v", err)
+	}
+
+	if addr1 != addr2 {
+		t.Fatalf("same mnemonic and derivation path derived different addresses: %s != %s", addr1, addr2)
+	}
+}
+
+func TestTransactorFromClefRequiresEndpointAndAccount(t *testing.T) {
+	cases := []struct {
+		name    string
+		signer  config.SignerConfig
+		wantErr bool
+	}{
+		{name: "missing endpoint", signer: config.SignerConfig{ClefAccount: "0x00000000000000000000000000000000000aaa"}, wantErr: true},
+		{name: "missing account", signer: config.SignerConfig{ClefEndpoint: "http://127.0.0.1:8550"}, wantErr: true},
+		{name: "missing both", signer: config.SignerConfig{}, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{Signer: tc.signer}
+			if _, _, err := transactorFromClef(cfg, testChainID); (err != nil) != tc.wantErr {
+				t.Fatalf("transactorFromClef() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func hexPrivateKey(key *ecdsa.PrivateKey) string {
+	return hex.EncodeToString(crypto.FromECDSA(key))
+}
+
+func writeFile(path string, data []byte) error {
+	return os.WriteFile(path, data, 0o600)
+}